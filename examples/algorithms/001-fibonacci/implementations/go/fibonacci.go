@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"math/big"
+	"math/bits"
 	"os"
 	"strconv"
 	"sync"
@@ -180,6 +181,55 @@ func FibBigInt(n int) *big.Int {
 	return curr
 }
 
+// FibFastDoubling computes Fibonacci using the fast-doubling identities
+//
+//	F(2k)   = F(k) * (2*F(k+1) - F(k))
+//	F(2k+1) = F(k)^2 + F(k+1)^2
+//
+// walking the bits of n from most to least significant and maintaining
+// (a, b) = (F(k), F(k+1)). Like FibMatrix this is O(log n), but it
+// performs about 2 multiplications per bit instead of matrix
+// multiplication's ~7.
+func FibFastDoubling(n int) uint64 {
+	var a, b uint64 = 0, 1
+	for k := bits.Len(uint(n)); k > 0; k-- {
+		c := a * (2*b - a)
+		d := a*a + b*b
+		a, b = c, d
+
+		if uint(n)>>uint(k-1)&1 == 1 {
+			a, b = b, a+b
+		}
+	}
+	return a
+}
+
+// FibFastDoublingBig is the big.Int variant of FibFastDoubling, for n
+// large enough to overflow uint64. This is where the fast-doubling
+// identities earn their keep: big.Int multiplication dominates the cost,
+// so halving the multiply count relative to FibMatrix roughly halves the
+// runtime.
+func FibFastDoublingBig(n int) *big.Int {
+	a := big.NewInt(0)
+	b := big.NewInt(1)
+	two := big.NewInt(2)
+
+	for k := bits.Len(uint(n)); k > 0; k-- {
+		c := new(big.Int).Sub(new(big.Int).Mul(two, b), a)
+		c.Mul(c, a)
+
+		d := new(big.Int).Mul(a, a)
+		d.Add(d, new(big.Int).Mul(b, b))
+
+		a, b = c, d
+
+		if uint(n)>>uint(k-1)&1 == 1 {
+			a, b = b, new(big.Int).Add(a, b)
+		}
+	}
+	return a
+}
+
 // FibTailRecursive computes Fibonacci tail-recursively
 func FibTailRecursive(n int) uint64 {
 	return fibTailHelper(n, 0, 1)
@@ -213,7 +263,7 @@ func BenchmarkBig(name string, n int, fn func(int) *big.Int) {
 func main() {
 	if len(os.Args) < 2 {
 		fmt.Printf("Usage: %s <n> [variant]\n", os.Args[0])
-		fmt.Println("Variants: recursive, iterative, memoized, matrix, channel, concurrent, tail, bigint")
+		fmt.Println("Variants: recursive, iterative, memoized, matrix, channel, concurrent, tail, bigint, fastdoubling, fastdoublingbig")
 		os.Exit(1)
 	}
 	
@@ -255,6 +305,10 @@ func main() {
 		Benchmark("TailRecursive", n, FibTailRecursive)
 	case "bigint":
 		BenchmarkBig("BigInt", n, FibBigInt)
+	case "fastdoubling":
+		Benchmark("FastDoubling", n, FibFastDoubling)
+	case "fastdoublingbig":
+		BenchmarkBig("FastDoublingBig", n, FibFastDoublingBig)
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown variant: %s\n", variant)
 		os.Exit(1)
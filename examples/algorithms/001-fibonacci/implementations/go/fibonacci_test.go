@@ -114,6 +114,41 @@ func TestFibBigInt(t *testing.T) {
 	}
 }
 
+func TestFibFastDoubling(t *testing.T) {
+	for _, tc := range testCases {
+		result := FibFastDoubling(tc.n)
+		if result != tc.expected {
+			t.Errorf("FibFastDoubling(%d) = %d; want %d", tc.n, result, tc.expected)
+		}
+	}
+}
+
+func TestFibFastDoublingBig(t *testing.T) {
+	for _, tc := range testCases {
+		result := FibFastDoublingBig(tc.n)
+		expected := big.NewInt(int64(tc.expected))
+		if result.Cmp(expected) != 0 {
+			t.Errorf("FibFastDoublingBig(%d) = %s; want %s", tc.n, result.String(), expected.String())
+		}
+	}
+
+	result100 := FibFastDoublingBig(100)
+	expected100, _ := new(big.Int).SetString("354224848179261915075", 10)
+	if result100.Cmp(expected100) != 0 {
+		t.Errorf("FibFastDoublingBig(100) = %s; want %s", result100.String(), expected100.String())
+	}
+}
+
+func TestFibFastDoublingBigMatchesFibBigInt(t *testing.T) {
+	for n := 0; n <= 5000; n++ {
+		got := FibFastDoublingBig(n)
+		want := FibBigInt(n)
+		if got.Cmp(want) != 0 {
+			t.Fatalf("FibFastDoublingBig(%d) = %s; want %s (FibBigInt)", n, got.String(), want.String())
+		}
+	}
+}
+
 // Benchmarks
 func BenchmarkFibRecursive30(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -173,4 +208,46 @@ func BenchmarkFibBigInt1000(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		FibBigInt(1000)
 	}
+}
+
+func BenchmarkFibFastDoubling1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibFastDoubling(1000)
+	}
+}
+
+func BenchmarkFibMatrix1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibMatrix(1000)
+	}
+}
+
+func BenchmarkFibFastDoublingBig1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibFastDoublingBig(1000)
+	}
+}
+
+func BenchmarkFibBigInt10000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibBigInt(10000)
+	}
+}
+
+func BenchmarkFibFastDoublingBig10000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibFastDoublingBig(10000)
+	}
+}
+
+func BenchmarkFibBigInt100000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibBigInt(100000)
+	}
+}
+
+func BenchmarkFibFastDoublingBig100000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibFastDoublingBig(100000)
+	}
 }
\ No newline at end of file
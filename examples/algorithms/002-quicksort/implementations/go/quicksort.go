@@ -1,195 +1,667 @@
 package main
 
 import (
+	"cmp"
 	"fmt"
-	"math/rand"
+	"math/bits"
 	"runtime"
-	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// Sortable interface for generic sorting
-type Sortable interface {
-	Sort()
-	IsSorted() bool
-	Len() int
-	Less(i, j int) bool
-	Swap(i, j int)
+// Tuning constants for the pdqsort engine, matching the thresholds Go's
+// own slices.Sort uses.
+const (
+	pdqInsertionThreshold       = 12
+	pdqNinetherThreshold        = 128
+	pdqPartialInsertionMaxSteps = 8
+)
+
+// Sort sorts a slice of an ordered type in place, mirroring the standard
+// library's slices.Sort.
+func Sort[T cmp.Ordered](s []T) {
+	QuicksortInplace(s)
 }
 
-// IntSlice implements Sortable for []int
-type IntSlice []int
+// SortFunc sorts s in place using cmp to compare elements. cmp must return
+// a negative number when a < b, a positive number when a > b, and zero
+// when a and b are equal, matching the shape of slices.SortFunc.
+//
+// SortFunc is a pattern-defeating quicksort (pdqsort): it falls back to
+// insertion sort on small partitions, picks pivots via median-of-three
+// (or a ninther on large partitions), detects already-sorted runs with a
+// bounded partial insertion sort, switches to three-way partitioning when
+// it spots many duplicate keys, and degrades to heapsort if partitioning
+// keeps landing badly unbalanced. That combination gives it guaranteed
+// O(n log n) worst-case behavior while staying close to linear on the
+// sorted, reversed, and few-unique inputs that defeat naive quicksort.
+func SortFunc[T any](s []T, cmp func(a, b T) int) {
+	if len(s) > 1 {
+		badAllowed := bits.Len(uint(len(s)))
+		pdqsortRange(s, 0, len(s)-1, badAllowed, cmp)
+	}
+}
 
-func (s IntSlice) Sort() {
-	QuicksortInplace(s)
+// pdqsortRange sorts arr[low:high+1] in place. badAllowed bounds how many
+// times partitioning may land unbalanced before this call gives up on
+// quicksort and falls back to heapsort for the remaining range.
+func pdqsortRange[T any](arr []T, low, high int, badAllowed int, cmp func(a, b T) int) {
+	for {
+		size := high - low + 1
+		if size <= 1 {
+			return
+		}
+		if size <= pdqInsertionThreshold {
+			insertionSortRange(arr, low, high, cmp)
+			return
+		}
+
+		// Already-sorted (or nearly so) input finishes in a handful of
+		// swaps; skip the partition entirely when it does.
+		if partialInsertionSort(arr, low, high, cmp) {
+			return
+		}
+
+		pivotIdx := choosePivot(arr, low, high, cmp)
+		arr[pivotIdx], arr[high] = arr[high], arr[pivotIdx]
+
+		// Many-duplicates case: the pivot equals the element just
+		// before this partition, so three-way partitioning avoids the
+		// quadratic blowup a two-way partition would hit here.
+		if low > 0 && cmp(arr[low-1], arr[high]) == 0 {
+			lt, gt := threeWayPartitionRange(arr, low, high, cmp)
+			if lt-low < high-gt {
+				pdqsortRange(arr, low, lt-1, badAllowed, cmp)
+				low = gt + 1
+			} else {
+				pdqsortRange(arr, gt+1, high, badAllowed, cmp)
+				high = lt - 1
+			}
+			continue
+		}
+
+		p := partitionFunc(arr, low, high, cmp)
+
+		leftLen, rightLen := p-low, high-p
+		if min(leftLen, rightLen)*3 < max(leftLen, rightLen) {
+			badAllowed--
+			if badAllowed < 0 {
+				heapsortRange(arr, low, high, cmp)
+				return
+			}
+			// Perturb each side within its own bounds only - shuffling
+			// across the partition boundary would undo the partition
+			// we just computed.
+			if leftLen >= pdqInsertionThreshold {
+				breakPatterns(arr, low, p-1)
+			}
+			if rightLen >= pdqInsertionThreshold {
+				breakPatterns(arr, p+1, high)
+			}
+		}
+
+		if leftLen < rightLen {
+			pdqsortRange(arr, low, p-1, badAllowed, cmp)
+			low = p + 1
+		} else {
+			pdqsortRange(arr, p+1, high, badAllowed, cmp)
+			high = p - 1
+		}
+	}
+}
+
+// choosePivot returns the index of the median-of-three for small and
+// medium partitions, or a ninther (median of three medians) for large
+// ones, so adversarial inputs can't reliably force a bad pivot.
+func choosePivot[T any](arr []T, low, high int, cmp func(a, b T) int) int {
+	size := high - low + 1
+	mid := low + size/2
+
+	if size <= pdqNinetherThreshold {
+		return medianIndex(arr, low, mid, high, cmp)
+	}
+
+	step := size / 8
+	m1 := medianIndex(arr, low, low+step, low+2*step, cmp)
+	m2 := medianIndex(arr, mid-step, mid, mid+step, cmp)
+	m3 := medianIndex(arr, high-2*step, high-step, high, cmp)
+	return medianIndex(arr, m1, m2, m3, cmp)
+}
+
+// medianIndex returns whichever of i, j, k indexes the median value,
+// without mutating arr.
+func medianIndex[T any](arr []T, i, j, k int, cmp func(a, b T) int) int {
+	if cmp(arr[i], arr[j]) < 0 {
+		if cmp(arr[j], arr[k]) < 0 {
+			return j
+		}
+		if cmp(arr[i], arr[k]) < 0 {
+			return k
+		}
+		return i
+	}
+	if cmp(arr[j], arr[k]) > 0 {
+		return j
+	}
+	if cmp(arr[i], arr[k]) > 0 {
+		return k
+	}
+	return i
+}
+
+// partialInsertionSort tries to finish arr[low:high+1] with at most
+// pdqPartialInsertionMaxSteps swaps and reports whether it succeeded.
+// This is cheap to attempt and turns already-sorted or nearly-sorted
+// partitions into a near-linear pass instead of a full quicksort.
+func partialInsertionSort[T any](arr []T, low, high int, cmp func(a, b T) int) bool {
+	swaps := 0
+	for i := low + 1; i <= high; i++ {
+		for j := i; j > low && cmp(arr[j-1], arr[j]) > 0; j-- {
+			arr[j-1], arr[j] = arr[j], arr[j-1]
+			swaps++
+			if swaps > pdqPartialInsertionMaxSteps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// breakPatterns swaps a few elements at fixed offsets around the middle
+// of arr[low:high+1] to break up adversarial patterns (e.g. organ-pipe or
+// sawtooth inputs) that would otherwise keep defeating median-of-three
+// pivot selection.
+func breakPatterns[T any](arr []T, low, high int) {
+	size := high - low + 1
+	if size < 8 {
+		return
+	}
+
+	seed := uint64(size)
+	next := func() uint64 {
+		seed ^= seed << 13
+		seed ^= seed >> 7
+		seed ^= seed << 17
+		return seed
+	}
+
+	mid := low + size/2
+	for _, pos := range [3]int{mid - 1, mid, mid + 1} {
+		other := low + int(next()%uint64(size))
+		arr[pos], arr[other] = arr[other], arr[pos]
+	}
+}
+
+// threeWayPartitionRange partitions arr[low:high+1] around arr[high] into
+// elements less than, equal to, and greater than the pivot, returning the
+// [lt, gt] bounds of the equal-to-pivot region.
+func threeWayPartitionRange[T any](arr []T, low, high int, cmp func(a, b T) int) (lt, gt int) {
+	pivot := arr[high]
+	lt, gt = low, high
+	i := low
+	for i <= gt {
+		switch c := cmp(arr[i], pivot); {
+		case c < 0:
+			arr[i], arr[lt] = arr[lt], arr[i]
+			lt++
+			i++
+		case c > 0:
+			arr[i], arr[gt] = arr[gt], arr[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// heapsortRange sorts arr[low:high+1] in place using a binary heap. It is
+// pdqsort's fallback when partitioning keeps landing unbalanced, which
+// guarantees O(n log n) even on adversarial inputs.
+func heapsortRange[T any](arr []T, low, high int, cmp func(a, b T) int) {
+	n := high - low + 1
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(arr, low, i, n, cmp)
+	}
+	for i := n - 1; i > 0; i-- {
+		arr[low], arr[low+i] = arr[low+i], arr[low]
+		siftDown(arr, low, 0, i, cmp)
+	}
+}
+
+func siftDown[T any](arr []T, low, i, n int, cmp func(a, b T) int) {
+	for {
+		left := 2*i + 1
+		if left >= n {
+			return
+		}
+		largest := left
+		if right := left + 1; right < n && cmp(arr[low+right], arr[low+left]) > 0 {
+			largest = right
+		}
+		if cmp(arr[low+largest], arr[low+i]) <= 0 {
+			return
+		}
+		arr[low+i], arr[low+largest] = arr[low+largest], arr[low+i]
+		i = largest
+	}
+}
+
+// insertionSortRange sorts arr[low:high+1] in place; used directly for
+// small partitions.
+func insertionSortRange[T any](arr []T, low, high int, cmp func(a, b T) int) {
+	for i := low + 1; i <= high; i++ {
+		for j := i; j > low && cmp(arr[j-1], arr[j]) > 0; j-- {
+			arr[j-1], arr[j] = arr[j], arr[j-1]
+		}
+	}
+}
+
+// SortStable sorts a slice of an ordered type in place, preserving the
+// relative order of elements that compare equal.
+func SortStable[T cmp.Ordered](s []T) {
+	SortStableFunc(s, cmp.Compare[T])
+}
+
+// SortStableFunc sorts s in place using cmp, preserving the relative order
+// of elements that compare equal. It is a bottom-up block merge sort:
+// adjacent runs are merged in place via symMerge's binary-search-and-
+// rotate scheme, so unlike a buffered merge sort it needs only O(1) extra
+// space at the cost of an extra log factor (O(n log^2 n)).
+func SortStableFunc[T any](s []T, cmp func(a, b T) int) {
+	n := len(s)
+	for width := 1; width < n; width *= 2 {
+		for lo := 0; lo+width < n; lo += 2 * width {
+			mid := lo + width
+			hi := min(lo+2*width, n)
+			symMerge(s, lo, mid, hi, cmp)
+		}
+	}
+}
+
+// symMerge merges the two adjacent sorted runs s[a:m] and s[m:b] in
+// place, preserving the order of equal elements. It works by locating,
+// via binary search, a rotation point that splits both runs so that
+// everything before it belongs in the result's first half and everything
+// after belongs in the second, then recurses on the (smaller) remaining
+// pieces.
+func symMerge[T any](s []T, a, m, b int, cmp func(x, y T) int) {
+	if a >= m || m >= b {
+		return
+	}
+
+	if m-a == 1 {
+		lo, hi := m, b
+		for lo < hi {
+			h := int(uint(lo+hi) >> 1)
+			if cmp(s[h], s[a]) < 0 {
+				lo = h + 1
+			} else {
+				hi = h
+			}
+		}
+		rotate(s, a, m, lo)
+		return
+	}
+
+	if b-m == 1 {
+		lo, hi := a, m
+		for lo < hi {
+			h := int(uint(lo+hi) >> 1)
+			if cmp(s[m], s[h]) < 0 {
+				hi = h
+			} else {
+				lo = h + 1
+			}
+		}
+		rotate(s, lo, m, b)
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	start, r := a, m
+	if m > mid {
+		start, r = n-b, mid
+	}
+	p := n - 1
+
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if cmp(s[c], s[p-c]) <= 0 {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotate(s, start, m, end)
+	}
+	if a < start && start < mid {
+		symMerge(s, a, start, mid, cmp)
+	}
+	if mid < end && end < b {
+		symMerge(s, mid, end, b, cmp)
+	}
 }
 
-func (s IntSlice) IsSorted() bool {
+// rotate swaps the two blocks s[a:m] and s[m:b] in place using three
+// reversals, so that s[m:b] ends up before s[a:m].
+func rotate[T any](s []T, a, m, b int) {
+	reverseRange(s, a, m)
+	reverseRange(s, m, b)
+	reverseRange(s, a, b)
+}
+
+func reverseRange[T any](s []T, i, j int) {
+	for i < j {
+		j--
+		s[i], s[j] = s[j], s[i]
+		i++
+	}
+}
+
+// IsSorted reports whether s is sorted in ascending order. Following the
+// Go 1.21 convention, a NaN is treated as less than every other value.
+func IsSorted[T cmp.Ordered](s []T) bool {
 	for i := 1; i < len(s); i++ {
-		if s[i-1] > s[i] {
+		if cmp.Less(s[i], s[i-1]) {
 			return false
 		}
 	}
 	return true
 }
 
-func (s IntSlice) Len() int           { return len(s) }
-func (s IntSlice) Less(i, j int) bool { return s[i] < s[j] }
-func (s IntSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+// BinarySearch searches for target in a sorted slice s and returns the
+// position where target is found, or the position where it would be
+// inserted in order, along with a boolean reporting whether it was found.
+func BinarySearch[T cmp.Ordered](s []T, target T) (int, bool) {
+	low, high := 0, len(s)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp.Less(s[mid], target) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, low < len(s) && s[low] == target
+}
 
-// QuicksortInplace sorts the slice in-place
-func QuicksortInplace(arr []int) {
-	if len(arr) > 1 {
-		quicksortRange(arr, 0, len(arr)-1)
+// BinarySearchFunc is like BinarySearch, but uses cmp to compare target
+// against each element of s instead of requiring cmp.Ordered. cmp must
+// return a negative number when elem < target, a positive number when
+// elem > target, and zero when they are equal.
+func BinarySearchFunc[T, E any](s []T, target E, cmp func(elem T, target E) int) (int, bool) {
+	low, high := 0, len(s)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(s[mid], target) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
 	}
+	return low, low < len(s) && cmp(s[low], target) == 0
 }
 
-func quicksortRange(arr []int, low, high int) {
-	if low < high {
-		pivotIndex := partition(arr, low, high)
-		
-		if pivotIndex > 0 {
-			quicksortRange(arr, low, pivotIndex-1)
+// Min returns the smallest element of s. It panics if s is empty.
+func Min[T cmp.Ordered](s []T) T {
+	m := s[0]
+	for _, x := range s[1:] {
+		if cmp.Less(x, m) {
+			m = x
 		}
-		if pivotIndex+1 <= high {
-			quicksortRange(arr, pivotIndex+1, high)
+	}
+	return m
+}
+
+// Max returns the largest element of s. It panics if s is empty.
+func Max[T cmp.Ordered](s []T) T {
+	m := s[0]
+	for _, x := range s[1:] {
+		if cmp.Less(m, x) {
+			m = x
 		}
 	}
+	return m
+}
+
+// QuicksortInplace sorts the slice in-place
+func QuicksortInplace[T cmp.Ordered](arr []T) {
+	SortFunc(arr, cmp.Compare[T])
 }
 
-func partition(arr []int, low, high int) int {
+// partitionFunc performs a Lomuto partition of arr[low:high+1] around
+// arr[high], used by pdqsortRange once a pivot has been moved into place.
+func partitionFunc[T any](arr []T, low, high int, cmp func(a, b T) int) int {
 	pivot := arr[high]
 	i := low
-	
+
 	for j := low; j < high; j++ {
-		if arr[j] <= pivot {
+		if cmp(arr[j], pivot) <= 0 {
 			arr[i], arr[j] = arr[j], arr[i]
 			i++
 		}
 	}
-	
+
 	arr[i], arr[high] = arr[high], arr[i]
 	return i
 }
 
 // QuicksortFunctional returns a new sorted slice
-func QuicksortFunctional(arr []int) []int {
+func QuicksortFunctional[T cmp.Ordered](arr []T) []T {
 	if len(arr) <= 1 {
-		result := make([]int, len(arr))
+		result := make([]T, len(arr))
 		copy(result, arr)
 		return result
 	}
-	
+
 	pivot := arr[len(arr)/2]
-	var less, equal, greater []int
-	
+	var less, equal, greater []T
+
 	for _, x := range arr {
-		if x < pivot {
+		switch {
+		case cmp.Less(x, pivot):
 			less = append(less, x)
-		} else if x == pivot {
-			equal = append(equal, x)
-		} else {
+		case cmp.Less(pivot, x):
 			greater = append(greater, x)
+		default:
+			equal = append(equal, x)
 		}
 	}
-	
+
 	sortedLess := QuicksortFunctional(less)
 	sortedGreater := QuicksortFunctional(greater)
-	
-	result := make([]int, 0, len(arr))
+
+	result := make([]T, 0, len(arr))
 	result = append(result, sortedLess...)
 	result = append(result, equal...)
 	result = append(result, sortedGreater...)
-	
+
 	return result
 }
 
 // QuicksortThreeWay sorts using three-way partitioning
-func QuicksortThreeWay(arr []int) {
+func QuicksortThreeWay[T cmp.Ordered](arr []T) {
 	if len(arr) <= 1 {
 		return
 	}
 	threeWayPartitionSort(arr, 0, len(arr)-1)
 }
 
-func threeWayPartitionSort(arr []int, low, high int) {
+func threeWayPartitionSort[T cmp.Ordered](arr []T, low, high int) {
 	if low >= high {
 		return
 	}
-	
+
 	pivot := arr[low]
 	lt := low
 	gt := high
 	i := low + 1
-	
+
 	for i <= gt {
-		if arr[i] < pivot {
+		switch {
+		case cmp.Less(arr[i], pivot):
 			arr[i], arr[lt] = arr[lt], arr[i]
 			lt++
 			i++
-		} else if arr[i] > pivot {
+		case cmp.Less(pivot, arr[i]):
 			arr[i], arr[gt] = arr[gt], arr[i]
 			gt--
-		} else {
+		default:
 			i++
 		}
 	}
-	
+
 	if lt > 0 {
 		threeWayPartitionSort(arr, low, lt-1)
 	}
 	threeWayPartitionSort(arr, gt+1, high)
 }
 
-// QuicksortParallel sorts using goroutines
-func QuicksortParallel(arr []int, threshold int) []int {
-	if len(arr) <= threshold {
-		return QuicksortFunctional(arr)
+// QuicksortParallel sorts arr in place, parallelizing across goroutines
+// once partitions grow past threshold. threshold doubles as the
+// sequential cutoff for SortParallelFunc.
+func QuicksortParallel[T cmp.Ordered](arr []T, threshold int) {
+	SortParallelFunc(arr, cmp.Compare[T], WithSequentialCutoff(threshold))
+}
+
+// ParallelOption configures SortParallelFunc.
+type ParallelOption func(*parallelOptions)
+
+type parallelOptions struct {
+	sequentialCutoff int
+	goroutineBudget  int
+}
+
+// defaultSequentialCutoff is the partition size below which
+// SortParallelFunc stops spawning goroutines and finishes sequentially,
+// tuned so the goroutine scheduling overhead doesn't dominate the sort.
+const defaultSequentialCutoff = 2048
+
+// WithSequentialCutoff overrides the partition size below which
+// SortParallelFunc sorts sequentially instead of spawning a goroutine.
+func WithSequentialCutoff(n int) ParallelOption {
+	return func(o *parallelOptions) { o.sequentialCutoff = n }
+}
+
+// WithGoroutineBudget overrides the maximum number of goroutines
+// SortParallelFunc may have in flight at once. It defaults to
+// runtime.GOMAXPROCS(0) * 4.
+func WithGoroutineBudget(n int) ParallelOption {
+	return func(o *parallelOptions) { o.goroutineBudget = n }
+}
+
+// SortParallelFunc sorts s in place using cmp, the same way SortFunc does,
+// except that it spawns a goroutine for the larger of the two
+// sub-partitions whenever that sub-partition exceeds the sequential
+// cutoff and a shared, atomically-decremented goroutine budget still has
+// capacity. Otherwise it recurses synchronously, so the goroutine count
+// stays bounded regardless of input size.
+func SortParallelFunc[T any](s []T, cmp func(a, b T) int, opts ...ParallelOption) {
+	if len(s) <= 1 {
+		return
 	}
-	
-	if len(arr) <= 1 {
-		result := make([]int, len(arr))
-		copy(result, arr)
-		return result
+
+	cfg := parallelOptions{
+		sequentialCutoff: defaultSequentialCutoff,
+		goroutineBudget:  runtime.GOMAXPROCS(0) * 4,
 	}
-	
-	pivot := arr[len(arr)/2]
-	var less, equal, greater []int
-	
-	for _, x := range arr {
-		if x < pivot {
-			less = append(less, x)
-		} else if x == pivot {
-			equal = append(equal, x)
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	budget := int64(cfg.goroutineBudget)
+	var wg sync.WaitGroup
+	badAllowed := bits.Len(uint(len(s)))
+	parallelPdqsortRange(s, 0, len(s)-1, badAllowed, cmp, cfg.sequentialCutoff, &budget, &wg)
+	wg.Wait()
+}
+
+// parallelPdqsortRange sorts arr[low:high+1] in place, sharing the same
+// pivot selection, small-partition insertion sort, partial-sort
+// detection, many-duplicates three-way partitioning, and heapsort
+// fallback as pdqsortRange.
+func parallelPdqsortRange[T any](arr []T, low, high, badAllowed int, cmp func(a, b T) int, cutoff int, budget *int64, wg *sync.WaitGroup) {
+	for {
+		size := high - low + 1
+		if size <= 1 {
+			return
+		}
+		if size <= cutoff {
+			pdqsortRange(arr, low, high, badAllowed, cmp)
+			return
+		}
+		if partialInsertionSort(arr, low, high, cmp) {
+			return
+		}
+
+		pivotIdx := choosePivot(arr, low, high, cmp)
+		arr[pivotIdx], arr[high] = arr[high], arr[pivotIdx]
+
+		var loA, hiA, loB, hiB int
+		if low > 0 && cmp(arr[low-1], arr[high]) == 0 {
+			lt, gt := threeWayPartitionRange(arr, low, high, cmp)
+			loA, hiA = low, lt-1
+			loB, hiB = gt+1, high
 		} else {
-			greater = append(greater, x)
+			p := partitionFunc(arr, low, high, cmp)
+			lenA, lenB := p-low, high-p
+			if min(lenA, lenB)*3 < max(lenA, lenB) {
+				badAllowed--
+				if badAllowed < 0 {
+					heapsortRange(arr, low, high, cmp)
+					return
+				}
+				if lenA >= pdqInsertionThreshold {
+					breakPatterns(arr, low, p-1)
+				}
+				if lenB >= pdqInsertionThreshold {
+					breakPatterns(arr, p+1, high)
+				}
+			}
+			loA, hiA = low, p-1
+			loB, hiB = p+1, high
 		}
+
+		smallLo, smallHi, largeLo, largeHi := loA, hiA, loB, hiB
+		if hiA-loA > hiB-loB {
+			smallLo, smallHi, largeLo, largeHi = loB, hiB, loA, hiA
+		}
+
+		if trySpawn(arr, largeLo, largeHi, badAllowed, cmp, cutoff, budget, wg) {
+			low, high = smallLo, smallHi
+			continue
+		}
+
+		parallelPdqsortRange(arr, smallLo, smallHi, badAllowed, cmp, cutoff, budget, wg)
+		low, high = largeLo, largeHi
 	}
-	
-	var wg sync.WaitGroup
-	var sortedLess, sortedGreater []int
-	
-	wg.Add(2)
-	
-	go func() {
-		defer wg.Done()
-		sortedLess = QuicksortParallel(less, threshold)
-	}()
-	
+}
+
+// trySpawn claims one unit of the shared goroutine budget and sorts
+// arr[lo:hi+1] in a new goroutine, reporting whether it did so. It
+// returns false, leaving the budget untouched, when the partition is too
+// small to bother parallelizing or the budget is already exhausted.
+func trySpawn[T any](arr []T, lo, hi, badAllowed int, cmp func(a, b T) int, cutoff int, budget *int64, wg *sync.WaitGroup) bool {
+	if hi-lo+1 <= cutoff {
+		return false
+	}
+	if atomic.AddInt64(budget, -1) < 0 {
+		atomic.AddInt64(budget, 1)
+		return false
+	}
+
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		sortedGreater = QuicksortParallel(greater, threshold)
+		parallelPdqsortRange(arr, lo, hi, badAllowed, cmp, cutoff, budget, wg)
 	}()
-	
-	wg.Wait()
-	
-	result := make([]int, 0, len(arr))
-	result = append(result, sortedLess...)
-	result = append(result, equal...)
-	result = append(result, sortedGreater...)
-	
-	return result
+	return true
 }
 
 // Benchmark function
@@ -209,61 +681,70 @@ func main() {
 		{1, 2, 3, 4, 5},
 		{5, 5, 5, 5, 5},
 	}
-	
-	fmt.Println("Quicksort Implementations Demo\n")
-	
+
+	fmt.Println("Quicksort Implementations Demo")
+	fmt.Println()
+
 	for i, arr := range testArrays {
 		fmt.Printf("Test case %d: %v\n", i+1, arr)
-		
+
 		arr1 := make([]int, len(arr))
 		copy(arr1, arr)
 		QuicksortInplace(arr1)
 		fmt.Printf("  In-place:    %v\n", arr1)
-		
+
 		arr2 := QuicksortFunctional(arr)
 		fmt.Printf("  Functional:  %v\n", arr2)
-		
+
 		arr3 := make([]int, len(arr))
 		copy(arr3, arr)
 		QuicksortThreeWay(arr3)
 		fmt.Printf("  Three-way:   %v\n", arr3)
-		
+
 		if len(arr) > 0 {
-			arr4 := QuicksortParallel(arr, 1000)
+			arr4 := make([]int, len(arr))
+			copy(arr4, arr)
+			QuicksortParallel(arr4, 1000)
 			fmt.Printf("  Parallel:    %v\n", arr4)
 		}
-		
+
 		fmt.Println()
 	}
-	
+
 	// Performance demonstration
 	fmt.Println("Performance demonstration with large array:")
 	largeArray := make([]int, 10000)
 	for i := range largeArray {
 		largeArray[i] = (i*37 + 11) % 1000
 	}
-	
+
 	fmt.Printf("  Array size: %d\n", len(largeArray))
 	fmt.Printf("  Number of CPUs: %d\n", runtime.NumCPU())
-	
+
 	benchmark("In-place", func() {
 		arrCopy := make([]int, len(largeArray))
 		copy(arrCopy, largeArray)
 		QuicksortInplace(arrCopy)
 	})
-	
+
 	benchmark("Functional", func() {
 		QuicksortFunctional(largeArray)
 	})
-	
+
 	benchmark("Parallel", func() {
-		QuicksortParallel(largeArray, 1000)
+		arrCopy := make([]int, len(largeArray))
+		copy(arrCopy, largeArray)
+		QuicksortParallel(arrCopy, 1000)
 	})
-	
-	// Test Sortable interface
-	fmt.Println("\nTesting Sortable interface:")
-	sortableArray := IntSlice{3, 1, 4, 1, 5, 9, 2, 6}
-	fmt.Printf("Before sorting: %v, IsSorted: %t\n", sortableArray, sortableArray.IsSorted())
-	sortableArray.Sort()
-	fmt.Printf("After sorting:  %v, IsSorted: %t\n", sortableArray, sortableArray.IsSorted())
-}
\ No newline at end of file
+
+	// Test the slices-style API surface
+	fmt.Println("\nTesting the slices-style API:")
+	generic := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	fmt.Printf("Before sorting: %v, IsSorted: %t\n", generic, IsSorted(generic))
+	Sort(generic)
+	fmt.Printf("After sorting:  %v, IsSorted: %t\n", generic, IsSorted(generic))
+	fmt.Printf("Min: %d, Max: %d\n", Min(generic), Max(generic))
+	if idx, found := BinarySearch(generic, 5); found {
+		fmt.Printf("BinarySearch(5) = %d\n", idx)
+	}
+}
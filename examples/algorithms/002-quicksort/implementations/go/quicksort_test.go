@@ -73,14 +73,14 @@ func TestQuicksortFunctional(t *testing.T) {
 	arr := []int{3, 1, 4, 1, 5, 9, 2, 6}
 	original := make([]int, len(arr))
 	copy(original, arr)
-	
+
 	sorted := QuicksortFunctional(arr)
 	expected := []int{1, 1, 2, 3, 4, 5, 6, 9}
-	
+
 	if !equal(sorted, expected) {
 		t.Errorf("Expected %v, got %v", expected, sorted)
 	}
-	
+
 	// Original should be unchanged
 	if !equal(arr, original) {
 		t.Errorf("Original array was modified: expected %v, got %v", original, arr)
@@ -98,31 +98,223 @@ func TestQuicksortThreeWay(t *testing.T) {
 
 func TestQuicksortParallel(t *testing.T) {
 	arr := []int{3, 1, 4, 1, 5, 9, 2, 6}
-	sorted := QuicksortParallel(arr, 1000)
+	QuicksortParallel(arr, 1000)
 	expected := []int{1, 1, 2, 3, 4, 5, 6, 9}
-	if !equal(sorted, expected) {
-		t.Errorf("Expected %v, got %v", expected, sorted)
+	if !equal(arr, expected) {
+		t.Errorf("Expected %v, got %v", expected, arr)
+	}
+}
+
+func TestQuicksortParallelLargeLowCutoff(t *testing.T) {
+	// A small cutoff forces many goroutine spawns on a modestly sized
+	// input, exercising the budget-limited fan-out.
+	arr := generateRandomArray(20000)
+	want := make([]int, len(arr))
+	copy(want, arr)
+	sort.Ints(want)
+
+	QuicksortParallel(arr, 64)
+	if !equal(arr, want) {
+		t.Error("QuicksortParallel with a small cutoff produced an unsorted result")
+	}
+}
+
+func TestSortParallelFuncOptions(t *testing.T) {
+	arr := generateRandomArray(5000)
+	want := make([]int, len(arr))
+	copy(want, arr)
+	sort.Ints(want)
+
+	SortParallelFunc(arr, func(a, b int) int { return a - b },
+		WithSequentialCutoff(128),
+		WithGoroutineBudget(2),
+	)
+	if !equal(arr, want) {
+		t.Error("SortParallelFunc produced an unsorted result")
+	}
+}
+
+func TestSortStrings(t *testing.T) {
+	s := []string{"banana", "apple", "cherry"}
+	Sort(s)
+	expected := []string{"apple", "banana", "cherry"}
+	for i := range expected {
+		if s[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, s)
+			break
+		}
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	s := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	SortFunc(s, func(a, b int) int { return b - a })
+	expected := []int{9, 6, 5, 4, 3, 2, 1, 1}
+	if !equal(s, expected) {
+		t.Errorf("Expected %v, got %v", expected, s)
+	}
+}
+
+func TestSortStableFunc(t *testing.T) {
+	type pair struct{ k, v int }
+	s := []pair{{1, 1}, {2, 1}, {1, 2}, {2, 2}, {1, 3}}
+	SortStableFunc(s, func(a, b pair) int { return a.k - b.k })
+
+	seenV := map[int][]int{}
+	for _, p := range s {
+		seenV[p.k] = append(seenV[p.k], p.v)
+	}
+	for k, vs := range seenV {
+		for i := 1; i < len(vs); i++ {
+			if vs[i] < vs[i-1] {
+				t.Errorf("key %d: values out of original order: %v", k, vs)
+			}
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted([]int{1, 2, 3}) {
+		t.Error("expected [1 2 3] to be sorted")
+	}
+	if IsSorted([]int{3, 2, 1}) {
+		t.Error("expected [3 2 1] to not be sorted")
+	}
+	if !IsSorted([]int{}) {
+		t.Error("expected empty slice to be sorted")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	s := []int{1, 3, 3, 5, 7, 9}
+	if idx, found := BinarySearch(s, 5); !found || idx != 3 {
+		t.Errorf("BinarySearch(5) = (%d, %t); want (3, true)", idx, found)
+	}
+	if idx, found := BinarySearch(s, 4); found || idx != 3 {
+		t.Errorf("BinarySearch(4) = (%d, %t); want (3, false)", idx, found)
+	}
+	if idx, found := BinarySearch(s, 0); found || idx != 0 {
+		t.Errorf("BinarySearch(0) = (%d, %t); want (0, false)", idx, found)
+	}
+	if idx, found := BinarySearch(s, 10); found || idx != len(s) {
+		t.Errorf("BinarySearch(10) = (%d, %t); want (%d, false)", idx, found, len(s))
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	s := []int{1, 3, 3, 5, 7, 9}
+	cmp := func(elem, target int) int { return elem - target }
+
+	if idx, found := BinarySearchFunc(s, 5, cmp); !found || idx != 3 {
+		t.Errorf("BinarySearchFunc(5) = (%d, %t); want (3, true)", idx, found)
+	}
+	if idx, found := BinarySearchFunc(s, 4, cmp); found || idx != 3 {
+		t.Errorf("BinarySearchFunc(4) = (%d, %t); want (3, false)", idx, found)
 	}
 }
 
-func TestSortableInterface(t *testing.T) {
-	sortable := IntSlice{3, 1, 4, 1, 5, 9, 2, 6}
-	if sortable.IsSorted() {
-		t.Error("Array should not be sorted initially")
+func TestSortStable(t *testing.T) {
+	s := []int{5, 3, 1, 4, 1, 5, 9, 2, 6}
+	SortStable(s)
+	expected := []int{1, 1, 2, 3, 4, 5, 5, 6, 9}
+	if !equal(s, expected) {
+		t.Errorf("Expected %v, got %v", expected, s)
 	}
-	
-	sortable.Sort()
-	if !sortable.IsSorted() {
-		t.Error("Array should be sorted after Sort()")
+}
+
+func TestMinMax(t *testing.T) {
+	s := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	if got := Min(s); got != 1 {
+		t.Errorf("Min(%v) = %d; want 1", s, got)
 	}
-	
-	expected := IntSlice{1, 1, 2, 3, 4, 5, 6, 9}
-	if !equal(sortable, expected) {
-		t.Errorf("Expected %v, got %v", expected, sortable)
+	if got := Max(s); got != 9 {
+		t.Errorf("Max(%v) = %d; want 9", s, got)
+	}
+}
+
+func TestQuicksortInplaceDistributions(t *testing.T) {
+	distributions := map[string]func(int) []int{
+		"sorted":    sortedArray,
+		"reversed":  reversedArray,
+		"sawtooth":  sawtoothArray,
+		"fewUnique": fewUniqueArray,
+		"random":    generateRandomArray,
+	}
+
+	for name, gen := range distributions {
+		arr := gen(500)
+		want := make([]int, len(arr))
+		copy(want, arr)
+		sort.Ints(want)
+
+		QuicksortInplace(arr)
+		if !equal(arr, want) {
+			t.Errorf("%s: got %v, want %v", name, arr, want)
+		}
 	}
 }
 
 // Property-based tests
+func TestPropertyBinarySearchMatchesLinearScan(t *testing.T) {
+	rand.Seed(42)
+	for i := 0; i < 1000; i++ {
+		size := rand.Intn(50)
+		arr := make([]int, size)
+		for j := range arr {
+			arr[j] = rand.Intn(50)
+		}
+		sort.Ints(arr)
+
+		target := rand.Intn(60) - 5
+
+		gotIdx, gotFound := BinarySearch(arr, target)
+
+		wantIdx, wantFound := len(arr), false
+		for j, v := range arr {
+			if v == target {
+				wantIdx, wantFound = j, true
+				break
+			}
+			if v > target {
+				wantIdx = j
+				break
+			}
+		}
+
+		if gotFound != wantFound || gotIdx != wantIdx {
+			t.Errorf("iteration %d: BinarySearch(%v, %d) = (%d, %t); linear scan wants (%d, %t)",
+				i, arr, target, gotIdx, gotFound, wantIdx, wantFound)
+		}
+	}
+}
+
+func TestPropertySortStablePreservesEqualKeyOrder(t *testing.T) {
+	type pair struct{ K, V int }
+
+	rand.Seed(42)
+	for i := 0; i < 200; i++ {
+		size := rand.Intn(100)
+		pairs := make([]pair, size)
+		for j := range pairs {
+			pairs[j] = pair{K: rand.Intn(10), V: j}
+		}
+
+		SortStableFunc(pairs, func(a, b pair) int { return a.K - b.K })
+
+		seenV := map[int][]int{}
+		for _, p := range pairs {
+			seenV[p.K] = append(seenV[p.K], p.V)
+		}
+		for k, vs := range seenV {
+			for j := 1; j < len(vs); j++ {
+				if vs[j] < vs[j-1] {
+					t.Errorf("iteration %d, key %d: original-index order not preserved: %v", i, k, vs)
+				}
+			}
+		}
+	}
+}
+
 func TestPropertySortedHasSameElements(t *testing.T) {
 	rand.Seed(42)
 	for i := 0; i < 1000; i++ {
@@ -131,15 +323,15 @@ func TestPropertySortedHasSameElements(t *testing.T) {
 		for j := range arr {
 			arr[j] = rand.Intn(200) - 100
 		}
-		
+
 		sorted := make([]int, len(arr))
 		copy(sorted, arr)
 		QuicksortInplace(sorted)
-		
+
 		origSorted := make([]int, len(arr))
 		copy(origSorted, arr)
 		sort.Ints(origSorted)
-		
+
 		if !equal(sorted, origSorted) {
 			t.Errorf("Iteration %d: quicksort result differs from sort.Ints", i)
 			t.Errorf("Original: %v", arr)
@@ -157,16 +349,11 @@ func TestPropertySortedIsOrdered(t *testing.T) {
 		for j := range arr {
 			arr[j] = rand.Intn(200) - 100
 		}
-		
+
 		QuicksortInplace(arr)
-		
-		for j := 0; j < len(arr)-1; j++ {
-			if arr[j] > arr[j+1] {
-				t.Errorf("Iteration %d: array not sorted at indices %d, %d: %d > %d", 
-					i, j, j+1, arr[j], arr[j+1])
-				t.Errorf("Full array: %v", arr)
-				break
-			}
+
+		if !IsSorted(arr) {
+			t.Errorf("Iteration %d: array not sorted: %v", i, arr)
 		}
 	}
 }
@@ -179,19 +366,21 @@ func TestImplementationConsistency(t *testing.T) {
 		for j := range arr {
 			arr[j] = rand.Intn(100) - 50
 		}
-		
+
 		arr1 := make([]int, len(arr))
 		copy(arr1, arr)
 		QuicksortInplace(arr1)
-		
+
 		arr2 := QuicksortFunctional(arr)
-		
+
 		arr3 := make([]int, len(arr))
 		copy(arr3, arr)
 		QuicksortThreeWay(arr3)
-		
-		arr4 := QuicksortParallel(arr, 1000)
-		
+
+		arr4 := make([]int, len(arr))
+		copy(arr4, arr)
+		QuicksortParallel(arr4, 1000)
+
 		if !equal(arr1, arr2) {
 			t.Errorf("Iteration %d: in-place vs functional mismatch", i)
 		}
@@ -237,7 +426,9 @@ func BenchmarkQuicksortParallel10K(b *testing.B) {
 	arr := generateRandomArray(10000)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		QuicksortParallel(arr, 1000)
+		arrCopy := make([]int, len(arr))
+		copy(arrCopy, arr)
+		QuicksortParallel(arrCopy, 1000)
 	}
 }
 
@@ -271,4 +462,95 @@ func generateRandomArray(size int) []int {
 		arr[i] = rand.Intn(2000) - 1000
 	}
 	return arr
-}
\ No newline at end of file
+}
+
+// Distribution generators for the pdqsort benchmarks below. pdqsort's
+// whole premise is that these "easy" shapes should run much closer to
+// linear than a naive quicksort manages.
+func sortedArray(size int) []int {
+	arr := make([]int, size)
+	for i := range arr {
+		arr[i] = i
+	}
+	return arr
+}
+
+func reversedArray(size int) []int {
+	arr := make([]int, size)
+	for i := range arr {
+		arr[i] = size - i
+	}
+	return arr
+}
+
+func sawtoothArray(size int) []int {
+	arr := make([]int, size)
+	for i := range arr {
+		arr[i] = i % 64
+	}
+	return arr
+}
+
+func fewUniqueArray(size int) []int {
+	arr := make([]int, size)
+	for i := range arr {
+		arr[i] = i % 4
+	}
+	return arr
+}
+
+func benchmarkDistribution(b *testing.B, gen func(int) []int, size int) {
+	arr := gen(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arrCopy := make([]int, len(arr))
+		copy(arrCopy, arr)
+		QuicksortInplace(arrCopy)
+	}
+}
+
+func benchmarkDistributionStdlib(b *testing.B, gen func(int) []int, size int) {
+	arr := gen(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arrCopy := make([]int, len(arr))
+		copy(arrCopy, arr)
+		sort.Ints(arrCopy)
+	}
+}
+
+func BenchmarkPDQSortRandom10K(b *testing.B)    { benchmarkDistribution(b, generateRandomArray, 10000) }
+func BenchmarkPDQSortSorted10K(b *testing.B)    { benchmarkDistribution(b, sortedArray, 10000) }
+func BenchmarkPDQSortReversed10K(b *testing.B)  { benchmarkDistribution(b, reversedArray, 10000) }
+func BenchmarkPDQSortSawtooth10K(b *testing.B)  { benchmarkDistribution(b, sawtoothArray, 10000) }
+func BenchmarkPDQSortFewUnique10K(b *testing.B) { benchmarkDistribution(b, fewUniqueArray, 10000) }
+
+func BenchmarkStdlibRandom10K(b *testing.B)    { benchmarkDistributionStdlib(b, generateRandomArray, 10000) }
+func BenchmarkStdlibSorted10K(b *testing.B)    { benchmarkDistributionStdlib(b, sortedArray, 10000) }
+func BenchmarkStdlibReversed10K(b *testing.B)  { benchmarkDistributionStdlib(b, reversedArray, 10000) }
+func BenchmarkStdlibSawtooth10K(b *testing.B)  { benchmarkDistributionStdlib(b, sawtoothArray, 10000) }
+func BenchmarkStdlibFewUnique10K(b *testing.B) { benchmarkDistributionStdlib(b, fewUniqueArray, 10000) }
+
+// Parallel vs. sequential pdqsort vs. stdlib, at sizes chosen to show the
+// crossover point where parallelizing starts paying for its own overhead.
+func benchmarkParallel(b *testing.B, size int) {
+	arr := generateRandomArray(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arrCopy := make([]int, len(arr))
+		copy(arrCopy, arr)
+		QuicksortParallel(arrCopy, defaultSequentialCutoff)
+	}
+}
+
+func BenchmarkParallelPDQSort10K(b *testing.B)  { benchmarkParallel(b, 10_000) }
+func BenchmarkParallelPDQSort100K(b *testing.B) { benchmarkParallel(b, 100_000) }
+func BenchmarkParallelPDQSort1M(b *testing.B)   { benchmarkParallel(b, 1_000_000) }
+
+func BenchmarkSequentialPDQSort10K(b *testing.B)  { benchmarkDistribution(b, generateRandomArray, 10_000) }
+func BenchmarkSequentialPDQSort100K(b *testing.B) { benchmarkDistribution(b, generateRandomArray, 100_000) }
+func BenchmarkSequentialPDQSort1M(b *testing.B)   { benchmarkDistribution(b, generateRandomArray, 1_000_000) }
+
+func BenchmarkStdlib10K(b *testing.B)  { benchmarkDistributionStdlib(b, generateRandomArray, 10_000) }
+func BenchmarkStdlib100K(b *testing.B) { benchmarkDistributionStdlib(b, generateRandomArray, 100_000) }
+func BenchmarkStdlib1M(b *testing.B)   { benchmarkDistributionStdlib(b, generateRandomArray, 1_000_000) }